@@ -5,143 +5,176 @@ package opensearch
 
 import (
 	"context"
-	"log"
+	"fmt"
+	"strings"
 	"time"
 
-	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/service/opensearchservice"
-	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/hashicorp/terraform-provider-aws/internal/conns"
-	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/opensearch"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/opensearch/types"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
-// @SDKResource("aws_opensearch_authorized_principal")
-func ResourceAuthorizedPrincipal() *schema.Resource {
-	return &schema.Resource{
-		CreateWithoutTimeout: resourceAuthorizedPrincipalUpsert,
-		ReadWithoutTimeout:   resourceAuthorizedPrincipalRead,
-		UpdateWithoutTimeout: resourceAuthorizedPrincipalUpsert,
-		DeleteWithoutTimeout: resourceAuthorizedPrincipalDelete,
+// @FrameworkResource("aws_opensearch_authorized_principal", name="Authorized Principal")
+func newResourceAuthorizedPrincipal(_ context.Context) (resource.ResourceWithConfigure, error) {
+	return &resourceAuthorizedPrincipal{}, nil
+}
 
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
+type resourceAuthorizedPrincipal struct {
+	framework.ResourceWithConfigure
+}
 
-		Timeouts: &schema.ResourceTimeout{
-			Create: schema.DefaultTimeout(10 * time.Minute),
-			Update: schema.DefaultTimeout(10 * time.Minute),
-			Delete: schema.DefaultTimeout(10 * time.Minute),
-		},
+func (r *resourceAuthorizedPrincipal) Metadata(_ context.Context, _ resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_opensearch_authorized_principal"
+}
 
-		Schema: map[string]*schema.Schema{
-			"domain_name": {
-				Type:     schema.TypeString,
+func (r *resourceAuthorizedPrincipal) Schema(_ context.Context, _ resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": framework.IDAttribute(),
+			"domain_name": schema.StringAttribute{
 				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
-			"account": {
-				Type:     schema.TypeString,
+			"account": schema.StringAttribute{
 				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 		},
 	}
 }
 
-func resourceAuthorizedPrincipalUpsert(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	var diags diag.Diagnostics
-
-	domain_name := d.Get("domain_name").(string)
-
-	conn := meta.(*conns.AWSClient).OpenSearchConn(ctx)
+type resourceAuthorizedPrincipalData struct {
+	ID         types.String `tfsdk:"id"`
+	DomainName types.String `tfsdk:"domain_name"`
+	Account    types.String `tfsdk:"account"`
+}
 
-	input := &opensearchservice.AuthorizeVpcEndpointAccessInput{
-		DomainName: aws.String(d.Get("domain_name").(string)),
-		Account:    aws.String(d.Get("account").(string)),
+func (r *resourceAuthorizedPrincipal) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data resourceAuthorizedPrincipalData
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
 	}
 
-	output, err := conn.AuthorizeVpcEndpointAccess(input)
+	conn := r.Meta().OpenSearchClient(ctx)
+	domainName := data.DomainName.ValueString()
+
+	output, err := conn.AuthorizeVpcEndpointAccess(ctx, &opensearch.AuthorizeVpcEndpointAccessInput{
+		DomainName: data.DomainName.ValueStringPointer(),
+		Account:    data.Account.ValueStringPointer(),
+	})
 
 	if err != nil {
-		return sdkdiag.AppendErrorf(diags, "Error authorizing Principal %s", err)
+		response.Diagnostics.AddError(fmt.Sprintf("creating OpenSearch Authorized Principal (%s)", domainName), err.Error())
+		return
 	}
 
-	d.SetId("authorized-principal-" + *output.AuthorizedPrincipal.Principal + "-" + *output.AuthorizedPrincipal.PrincipalType + "-" + domain_name)
+	data.ID = types.StringValue(authorizedPrincipalID(domainName, output.AuthorizedPrincipal))
 
-	if err := waitForDomainUpdate(ctx, conn, domain_name, d.Timeout(schema.TimeoutCreate)); err != nil {
-		return sdkdiag.AppendErrorf(diags, "Error authorizing principal %s: %s", d.Id(), err)
+	waiter := &OperationWaiter{Ctx: ctx, ConnV2: conn, Target: WaitPrincipalAuthorized, DomainName: domainName, Account: data.Account.ValueString()}
+	if _, err := waiter.Conf(10 * time.Minute).WaitForStateContext(ctx); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for OpenSearch Authorized Principal (%s) create", domainName), err.Error())
+		return
 	}
 
-	return append(diags, resourceAuthorizedPrincipalRead(ctx, d, meta)...)
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
 }
 
-func resourceAuthorizedPrincipalRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	var diags diag.Diagnostics
-	conn := meta.(*conns.AWSClient).OpenSearchConn(ctx)
+func (r *resourceAuthorizedPrincipal) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data resourceAuthorizedPrincipalData
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().OpenSearchClient(ctx)
 
-	principals, err := FindAuthorizedPrincipals(ctx, conn, d.Get("domain_name").(string), d.Id())
+	_, err := FindAuthorizedPrincipal(ctx, conn, data.DomainName.ValueString(), data.Account.ValueString())
 
-	if !d.IsNewResource() && !tfresource.NotFound(err) {
-		log.Printf("[WARN] OpenSearch Authorized Principal (%s) not found, removing from state", d.Id())
-		d.SetId("")
-		return diags
+	if tfresource.NotFound(err) {
+		response.Diagnostics.AddWarning(
+			"OpenSearch Authorized Principal not found",
+			fmt.Sprintf("OpenSearch Authorized Principal (%s) not found, removing from state", data.ID.ValueString()),
+		)
+		response.State.RemoveResource(ctx)
+		return
 	}
 
 	if err != nil {
-		return sdkdiag.AppendErrorf(diags, "reading OpenSearch Authorized Principal (%s): %s", d.Id(), err)
+		response.Diagnostics.AddError(fmt.Sprintf("reading OpenSearch Authorized Principal (%s)", data.ID.ValueString()), err.Error())
+		return
 	}
 
-	d.Set("authorized_principals", principals)
-
-	return diags
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
 }
 
-func FindAuthorizedPrincipals(ctx context.Context, conn *opensearchservice.OpenSearchService, domainName string, id string) ([]*opensearchservice.AuthorizedPrincipal, error) {
-	input := &opensearchservice.ListVpcEndpointAccessInput{
-		DomainName: aws.String(domainName),
+// Update is required to satisfy resource.Resource, but every attribute of
+// this resource is RequiresReplace, so Terraform never actually calls it;
+// it just passes the planned value straight through.
+func (r *resourceAuthorizedPrincipal) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var data resourceAuthorizedPrincipalData
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
 	}
 
-	output, err := conn.ListVpcEndpointAccess(input)
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
 
-	if tfawserr.ErrCodeEquals(err, opensearchservice.ErrCodeResourceNotFoundException) {
-		return nil, &retry.NotFoundError{
-			LastError:   err,
-			LastRequest: input,
-		}
+func (r *resourceAuthorizedPrincipal) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data resourceAuthorizedPrincipalData
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
 	}
 
+	conn := r.Meta().OpenSearchClient(ctx)
+	domainName := data.DomainName.ValueString()
+
+	_, err := conn.RevokeVpcEndpointAccess(ctx, &opensearch.RevokeVpcEndpointAccessInput{
+		DomainName: data.DomainName.ValueStringPointer(),
+		Account:    data.Account.ValueStringPointer(),
+	})
+
 	if err != nil {
-		return nil, err
+		response.Diagnostics.AddError(fmt.Sprintf("deleting OpenSearch Authorized Principal (%s)", data.ID.ValueString()), err.Error())
+		return
 	}
 
-	if output == nil || len(output.AuthorizedPrincipalList) == 0 || output.AuthorizedPrincipalList[0] == nil {
-		return nil, tfresource.NewEmptyResultError(input)
+	waiter := &OperationWaiter{Ctx: ctx, ConnV2: conn, Target: WaitDomainProcessing, DomainName: domainName}
+	if _, err := waiter.Conf(10 * time.Minute).WaitForStateContext(ctx); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for OpenSearch Domain (%s) update", domainName), err.Error())
+		return
 	}
-
-	return output.AuthorizedPrincipalList, nil
-
 }
 
-func resourceAuthorizedPrincipalDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	var diags diag.Diagnostics
-	conn := meta.(*conns.AWSClient).OpenSearchConn(ctx)
+func (r *resourceAuthorizedPrincipal) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), request, response)
 
-	input := &opensearchservice.RevokeVpcEndpointAccessInput{
-		DomainName: aws.String(d.Get("domain_name").(string)),
-		Account:    aws.String(d.Get("account").(string)),
+	// The ID is authorized-principal-<principal>-<principal type>-<domain name>, as built by authorizedPrincipalID.
+	const prefix = "authorized-principal-"
+	parts := strings.SplitN(strings.TrimPrefix(request.ID, prefix), "-", 3)
+	if !strings.HasPrefix(request.ID, prefix) || len(parts) != 3 {
+		response.Diagnostics.AddError("importing OpenSearch Authorized Principal", fmt.Sprintf("unexpected format for ID (%s), expected %s<principal>-<principal type>-<domain name>", request.ID, prefix))
+		return
 	}
 
-	_, err := conn.RevokeVpcEndpointAccess(input)
-
-	if err != nil {
-		return sdkdiag.AppendErrorf(diags, "Error rejecting principal %s: %s", d.Id(), err)
-	}
-
-	if err := waitForDomainUpdate(ctx, conn, d.Get("domain_name").(string), d.Timeout(schema.TimeoutDelete)); err != nil {
-		return sdkdiag.AppendErrorf(diags, "Error rejecting principal %s: %s", d.Id(), err)
-	}
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("account"), parts[0])...)
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("domain_name"), parts[2])...)
+}
 
-	return diags
+func authorizedPrincipalID(domainName string, principal *awstypes.AuthorizedPrincipal) string {
+	return fmt.Sprintf("authorized-principal-%s-%s-%s", aws.ToString(principal.Principal), principal.PrincipalType, domainName)
 }
@@ -0,0 +1,28 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package opensearch
+
+import (
+	"testing"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/opensearch/types"
+)
+
+func TestAuthorizedPrincipalID(t *testing.T) {
+	t.Parallel()
+
+	got := authorizedPrincipalID("my-domain", &awstypes.AuthorizedPrincipal{
+		Principal:     stringPtr("123456789012"),
+		PrincipalType: awstypes.PrincipalTypeAwsAccount,
+	})
+
+	want := "authorized-principal-123456789012-AWS_ACCOUNT-my-domain"
+	if got != want {
+		t.Errorf("authorizedPrincipalID() = %q, want %q", got, want)
+	}
+}
+
+func stringPtr(s string) *string {
+	return &s
+}
@@ -0,0 +1,115 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package opensearch
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/opensearchservice"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// @SDKDataSource("aws_opensearch_authorized_principals")
+func DataSourceAuthorizedPrincipals() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceAuthorizedPrincipalsRead,
+
+		Schema: map[string]*schema.Schema{
+			"domain_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"authorized_principals": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"principal": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"principal_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAuthorizedPrincipalsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).OpenSearchConn(ctx)
+
+	domainName := d.Get("domain_name").(string)
+
+	principals, err := FindAuthorizedPrincipals(ctx, conn, domainName, domainName)
+
+	if err != nil && !tfresource.NotFound(err) {
+		return sdkdiag.AppendErrorf(diags, "reading OpenSearch Authorized Principals (%s): %s", domainName, err)
+	}
+
+	d.SetId(domainName)
+
+	if err := d.Set("authorized_principals", FlattenAuthorizedPrincipals(principals)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting authorized_principals: %s", err)
+	}
+
+	return diags
+}
+
+func FindAuthorizedPrincipals(ctx context.Context, conn *opensearchservice.OpenSearchService, domainName string, id string) ([]*opensearchservice.AuthorizedPrincipal, error) {
+	input := &opensearchservice.ListVpcEndpointAccessInput{
+		DomainName: aws.String(domainName),
+	}
+
+	output, err := conn.ListVpcEndpointAccess(input)
+
+	if tfawserr.ErrCodeEquals(err, opensearchservice.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.AuthorizedPrincipalList) == 0 || output.AuthorizedPrincipalList[0] == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.AuthorizedPrincipalList, nil
+}
+
+func FlattenAuthorizedPrincipals(apiObjects []*opensearchservice.AuthorizedPrincipal) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"principal":      aws.StringValue(apiObject.Principal),
+			"principal_type": aws.StringValue(apiObject.PrincipalType),
+		})
+	}
+
+	return tfList
+}
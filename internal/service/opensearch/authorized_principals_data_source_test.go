@@ -0,0 +1,91 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package opensearch_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/opensearchservice"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfopensearch "github.com/hashicorp/terraform-provider-aws/internal/service/opensearch"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestFlattenAuthorizedPrincipals(t *testing.T) {
+	t.Parallel()
+
+	if got := tfopensearch.FlattenAuthorizedPrincipals(nil); got != nil {
+		t.Errorf("expected nil for empty input, got %v", got)
+	}
+
+	got := tfopensearch.FlattenAuthorizedPrincipals([]*opensearchservice.AuthorizedPrincipal{
+		{
+			Principal:     aws.String("123456789012"),
+			PrincipalType: aws.String("AWS_ACCOUNT"),
+		},
+		nil,
+	})
+
+	if len(got) != 1 {
+		t.Fatalf("expected 1 element, got %d", len(got))
+	}
+
+	tfMap := got[0].(map[string]interface{})
+	if tfMap["principal"] != "123456789012" {
+		t.Errorf("principal = %v, want 123456789012", tfMap["principal"])
+	}
+	if tfMap["principal_type"] != "AWS_ACCOUNT" {
+		t.Errorf("principal_type = %v, want AWS_ACCOUNT", tfMap["principal_type"])
+	}
+}
+
+func TestAccOpenSearchAuthorizedPrincipalsDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	dataSourceName := "data.aws_opensearch_authorized_principals.test"
+	resourceName := "aws_opensearch_authorized_principal.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.OpenSearchServiceEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAuthorizedPrincipalsDataSourceConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "domain_name", resourceName, "domain_name"),
+					resource.TestCheckResourceAttr(dataSourceName, "authorized_principals.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "authorized_principals.0.principal", resourceName, "account"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAuthorizedPrincipalsDataSourceConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+resource "aws_opensearch_domain" "test" {
+  domain_name = %[1]q
+}
+
+resource "aws_opensearch_authorized_principal" "test" {
+  domain_name = aws_opensearch_domain.test.domain_name
+  account     = data.aws_caller_identity.current.account_id
+}
+
+data "aws_opensearch_authorized_principals" "test" {
+  domain_name = aws_opensearch_authorized_principal.test.domain_name
+}
+`, rName)
+}
@@ -0,0 +1,179 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package opensearch
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/opensearchservice"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// @SDKResource("aws_opensearch_domain")
+func ResourceDomain() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceDomainCreate,
+		ReadWithoutTimeout:   resourceDomainRead,
+		UpdateWithoutTimeout: resourceDomainUpdate,
+		DeleteWithoutTimeout: resourceDomainDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"domain_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"endpoint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"engine_version": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"authorized_accounts": authorizedAccountsSchema(),
+		},
+	}
+}
+
+func resourceDomainCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).OpenSearchConn(ctx)
+
+	domainName := d.Get("domain_name").(string)
+
+	input := &opensearchservice.CreateDomainInput{
+		DomainName: aws.String(domainName),
+	}
+
+	if v, ok := d.GetOk("engine_version"); ok {
+		input.EngineVersion = aws.String(v.(string))
+	}
+
+	_, err := conn.CreateDomain(input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating OpenSearch Domain (%s): %s", domainName, err)
+	}
+
+	d.SetId(domainName)
+
+	waiter := &OperationWaiter{Ctx: ctx, Conn: conn, Target: WaitDomainProcessing, DomainName: domainName}
+	if _, err := waiter.Conf(domainProcessingTimeout).WaitForStateContext(ctx); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for OpenSearch Domain (%s) create: %s", domainName, err)
+	}
+
+	if v, ok := d.GetOk("authorized_accounts"); ok {
+		if diags := reconcileAuthorizedAccounts(ctx, conn, domainName, v.(*schema.Set)); diags.HasError() {
+			return diags
+		}
+	}
+
+	return append(diags, resourceDomainRead(ctx, d, meta)...)
+}
+
+func resourceDomainRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).OpenSearchConn(ctx)
+
+	domain, err := FindDomainStatusByName(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] OpenSearch Domain (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading OpenSearch Domain (%s): %s", d.Id(), err)
+	}
+
+	d.Set("domain_name", domain.DomainName)
+	d.Set("arn", domain.ARN)
+	d.Set("endpoint", domain.Endpoint)
+	d.Set("engine_version", domain.EngineVersion)
+
+	principals, err := FindAuthorizedPrincipals(ctx, conn, d.Id(), d.Id())
+
+	if err != nil && !tfresource.NotFound(err) {
+		return sdkdiag.AppendErrorf(diags, "reading OpenSearch VPC Endpoint Access for Domain (%s): %s", d.Id(), err)
+	}
+
+	accounts := make([]interface{}, 0, len(principals))
+	for _, principal := range principals {
+		accounts = append(accounts, aws.StringValue(principal.Principal))
+	}
+
+	if err := d.Set("authorized_accounts", accounts); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting authorized_accounts: %s", err)
+	}
+
+	return diags
+}
+
+func resourceDomainUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).OpenSearchConn(ctx)
+
+	domainName := d.Id()
+
+	if d.HasChange("engine_version") {
+		_, err := conn.UpdateDomainConfig(&opensearchservice.UpdateDomainConfigInput{
+			DomainName:    aws.String(domainName),
+			EngineVersion: aws.String(d.Get("engine_version").(string)),
+		})
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating OpenSearch Domain (%s): %s", domainName, err)
+		}
+
+		waiter := &OperationWaiter{Ctx: ctx, Conn: conn, Target: WaitDomainProcessing, DomainName: domainName}
+		if _, err := waiter.Conf(domainProcessingTimeout).WaitForStateContext(ctx); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for OpenSearch Domain (%s) update: %s", domainName, err)
+		}
+	}
+
+	if d.HasChange("authorized_accounts") {
+		if diags := reconcileAuthorizedAccounts(ctx, conn, domainName, d.Get("authorized_accounts").(*schema.Set)); diags.HasError() {
+			return diags
+		}
+	}
+
+	return append(diags, resourceDomainRead(ctx, d, meta)...)
+}
+
+func resourceDomainDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).OpenSearchConn(ctx)
+
+	_, err := conn.DeleteDomain(&opensearchservice.DeleteDomainInput{
+		DomainName: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, opensearchservice.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting OpenSearch Domain (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
@@ -0,0 +1,109 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package opensearch
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/opensearchservice"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// authorizedAccountsSchema returns the optional "authorized_accounts" set
+// attribute for aws_opensearch_domain. It lets a user inline the set of
+// accounts allowed to create VPC endpoints against the domain instead of
+// declaring one aws_opensearch_authorized_principal per account.
+func authorizedAccountsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeSet,
+		Optional:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Description: "NOTE: Do not use this and a standalone aws_opensearch_authorized_principal or aws_opensearch_vpc_endpoint_authorized_principal resource to manage authorized principals on the same domain. This will cause a conflict and accounts will be continually revoked and re-authorized.",
+	}
+}
+
+// reconcileAuthorizedAccounts diffs the desired set of authorized accounts
+// against ListVpcEndpointAccess, issues AuthorizeVpcEndpointAccess and
+// RevokeVpcEndpointAccess calls for the delta, and waits for the domain to
+// leave Processing before returning.
+func reconcileAuthorizedAccounts(ctx context.Context, conn *opensearchservice.OpenSearchService, domainName string, desired *schema.Set) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	principals, err := FindAuthorizedPrincipals(ctx, conn, domainName, domainName)
+
+	if err != nil && !tfresource.NotFound(err) {
+		return sdkdiag.AppendErrorf(diags, "listing OpenSearch VPC Endpoint Access for Domain (%s): %s", domainName, err)
+	}
+
+	current := make([]string, 0, len(principals))
+	for _, principal := range principals {
+		current = append(current, aws.StringValue(principal.Principal))
+	}
+
+	want := make([]string, 0, desired.Len())
+	for _, v := range desired.List() {
+		want = append(want, v.(string))
+	}
+
+	toAuthorize, toRevoke := diffAuthorizedAccounts(current, want)
+
+	for _, account := range toAuthorize {
+		if _, err := conn.AuthorizeVpcEndpointAccess(&opensearchservice.AuthorizeVpcEndpointAccessInput{
+			DomainName: aws.String(domainName),
+			Account:    aws.String(account),
+		}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "authorizing account %s on OpenSearch Domain (%s): %s", account, domainName, err)
+		}
+	}
+
+	for _, account := range toRevoke {
+		if _, err := conn.RevokeVpcEndpointAccess(&opensearchservice.RevokeVpcEndpointAccessInput{
+			DomainName: aws.String(domainName),
+			Account:    aws.String(account),
+		}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "revoking account %s on OpenSearch Domain (%s): %s", account, domainName, err)
+		}
+	}
+
+	waiter := &OperationWaiter{Ctx: ctx, Conn: conn, Target: WaitDomainProcessing, DomainName: domainName}
+	if _, err := waiter.Conf(domainProcessingTimeout).WaitForStateContext(ctx); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for OpenSearch Domain (%s) update: %s", domainName, err)
+	}
+
+	return diags
+}
+
+// diffAuthorizedAccounts compares the accounts ListVpcEndpointAccess reports
+// as currently authorized against the desired set and returns the accounts
+// reconcileAuthorizedAccounts still needs to authorize and revoke to make
+// them match.
+func diffAuthorizedAccounts(current, desired []string) (toAuthorize, toRevoke []string) {
+	currentSet := make(map[string]bool, len(current))
+	for _, account := range current {
+		currentSet[account] = true
+	}
+
+	desiredSet := make(map[string]bool, len(desired))
+	for _, account := range desired {
+		desiredSet[account] = true
+	}
+
+	for _, account := range desired {
+		if !currentSet[account] {
+			toAuthorize = append(toAuthorize, account)
+		}
+	}
+
+	for _, account := range current {
+		if !desiredSet[account] {
+			toRevoke = append(toRevoke, account)
+		}
+	}
+
+	return toAuthorize, toRevoke
+}
@@ -0,0 +1,65 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package opensearch
+
+import (
+	"sort"
+	"testing"
+)
+
+func TestDiffAuthorizedAccounts(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		current         []string
+		desired         []string
+		wantToAuthorize []string
+		wantToRevoke    []string
+	}{
+		"no change": {
+			current:         []string{"111111111111"},
+			desired:         []string{"111111111111"},
+			wantToAuthorize: nil,
+			wantToRevoke:    nil,
+		},
+		"authorize only": {
+			current:         nil,
+			desired:         []string{"111111111111", "222222222222"},
+			wantToAuthorize: []string{"111111111111", "222222222222"},
+			wantToRevoke:    nil,
+		},
+		"revoke only": {
+			current:         []string{"111111111111", "222222222222"},
+			desired:         nil,
+			wantToAuthorize: nil,
+			wantToRevoke:    []string{"111111111111", "222222222222"},
+		},
+		"authorize and revoke": {
+			current:         []string{"111111111111"},
+			desired:         []string{"222222222222"},
+			wantToAuthorize: []string{"222222222222"},
+			wantToRevoke:    []string{"111111111111"},
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			gotToAuthorize, gotToRevoke := diffAuthorizedAccounts(tc.current, tc.desired)
+
+			sort.Strings(gotToAuthorize)
+			sort.Strings(gotToRevoke)
+
+			if !stringSlicesEqual(gotToAuthorize, tc.wantToAuthorize) {
+				t.Errorf("toAuthorize = %v, want %v", gotToAuthorize, tc.wantToAuthorize)
+			}
+
+			if !stringSlicesEqual(gotToRevoke, tc.wantToRevoke) {
+				t.Errorf("toRevoke = %v, want %v", gotToRevoke, tc.wantToRevoke)
+			}
+		})
+	}
+}
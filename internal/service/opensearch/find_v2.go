@@ -0,0 +1,40 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package opensearch
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/opensearch"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/opensearch/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+)
+
+// FindAuthorizedPrincipal backs the Read methods of the Plugin Framework
+// resources in this package. It duplicates the shape of
+// FindAuthorizedPrincipals in authorized_principals_data_source.go because
+// that one uses the aws-sdk-go (v1) OpenSearchService client, while these
+// resources use aws-sdk-go-v2. OperationWaiter (waiter.go) also calls it to
+// poll a principal's authorization status for WaitPrincipalAuthorized.
+func FindAuthorizedPrincipal(ctx context.Context, conn *opensearch.Client, domainName, account string) (*awstypes.AuthorizedPrincipal, error) {
+	input := &opensearch.ListVpcEndpointAccessInput{
+		DomainName: aws.String(domainName),
+	}
+
+	output, err := conn.ListVpcEndpointAccess(ctx, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, principal := range output.AuthorizedPrincipalList {
+		principal := principal
+		if aws.ToString(principal.Principal) == account {
+			return &principal, nil
+		}
+	}
+
+	return nil, &retry.NotFoundError{LastRequest: input}
+}
@@ -0,0 +1,257 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package opensearch
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/opensearchservice"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+const (
+	vpcEndpointStatusCreating = "CREATING"
+	vpcEndpointStatusUpdating = "UPDATING"
+	vpcEndpointStatusActive   = "ACTIVE"
+	vpcEndpointStatusDeleting = "DELETING"
+)
+
+// @SDKResource("aws_opensearch_vpc_endpoint")
+func ResourceVpcEndpoint() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceVpcEndpointCreate,
+		ReadWithoutTimeout:   resourceVpcEndpointRead,
+		UpdateWithoutTimeout: resourceVpcEndpointUpdate,
+		DeleteWithoutTimeout: resourceVpcEndpointDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"domain_arn": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"vpc_options": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"security_group_ids": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"subnet_ids": {
+							Type:     schema.TypeSet,
+							Required: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"endpoint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"vpc_endpoint_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceVpcEndpointCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).OpenSearchConn(ctx)
+
+	input := &opensearchservice.CreateVpcEndpointInput{
+		DomainArn:  aws.String(d.Get("domain_arn").(string)),
+		VpcOptions: ExpandVPCOptions(d.Get("vpc_options").([]interface{})),
+	}
+
+	output, err := conn.CreateVpcEndpoint(input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating OpenSearch VPC Endpoint: %s", err)
+	}
+
+	d.SetId(aws.StringValue(output.VpcEndpoint.VpcEndpointId))
+
+	waiter := &OperationWaiter{Ctx: ctx, Conn: conn, Target: WaitVpcEndpointActive, VpcEndpointId: d.Id()}
+	if _, err := waiter.Conf(d.Timeout(schema.TimeoutCreate)).WaitForStateContext(ctx); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for OpenSearch VPC Endpoint (%s) create: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceVpcEndpointRead(ctx, d, meta)...)
+}
+
+func resourceVpcEndpointRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).OpenSearchConn(ctx)
+
+	vpcEndpoint, err := FindVPCEndpointByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] OpenSearch VPC Endpoint (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading OpenSearch VPC Endpoint (%s): %s", d.Id(), err)
+	}
+
+	d.Set("domain_arn", vpcEndpoint.DomainArn)
+	d.Set("endpoint", vpcEndpoint.Endpoint)
+	d.Set("status", vpcEndpoint.Status)
+	d.Set("vpc_endpoint_id", vpcEndpoint.VpcEndpointId)
+
+	if err := d.Set("vpc_options", FlattenVPCDerivedInfo(vpcEndpoint.VpcOptions)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting vpc_options: %s", err)
+	}
+
+	return diags
+}
+
+func resourceVpcEndpointUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).OpenSearchConn(ctx)
+
+	if d.HasChange("vpc_options") {
+		input := &opensearchservice.UpdateVpcEndpointInput{
+			VpcEndpointId: aws.String(d.Id()),
+			VpcOptions:    ExpandVPCOptions(d.Get("vpc_options").([]interface{})),
+		}
+
+		_, err := conn.UpdateVpcEndpoint(input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating OpenSearch VPC Endpoint (%s): %s", d.Id(), err)
+		}
+
+		waiter := &OperationWaiter{Ctx: ctx, Conn: conn, Target: WaitVpcEndpointActive, VpcEndpointId: d.Id()}
+		if _, err := waiter.Conf(d.Timeout(schema.TimeoutUpdate)).WaitForStateContext(ctx); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for OpenSearch VPC Endpoint (%s) update: %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceVpcEndpointRead(ctx, d, meta)...)
+}
+
+func resourceVpcEndpointDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).OpenSearchConn(ctx)
+
+	_, err := conn.DeleteVpcEndpoint(&opensearchservice.DeleteVpcEndpointInput{
+		VpcEndpointId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, opensearchservice.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting OpenSearch VPC Endpoint (%s): %s", d.Id(), err)
+	}
+
+	waiter := &OperationWaiter{Ctx: ctx, Conn: conn, Target: WaitVpcEndpointDeleted, VpcEndpointId: d.Id()}
+	if _, err := waiter.Conf(d.Timeout(schema.TimeoutDelete)).WaitForStateContext(ctx); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for OpenSearch VPC Endpoint (%s) delete: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func FindVPCEndpointByID(ctx context.Context, conn *opensearchservice.OpenSearchService, id string) (*opensearchservice.VpcEndpoint, error) {
+	input := &opensearchservice.DescribeVpcEndpointsInput{
+		VpcEndpointIds: aws.StringSlice([]string{id}),
+	}
+
+	output, err := conn.DescribeVpcEndpointsWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, opensearchservice.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.VpcEndpoints) == 0 || output.VpcEndpoints[0] == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.VpcEndpoints[0], nil
+}
+
+func ExpandVPCOptions(l []interface{}) *opensearchservice.VPCOptions {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	tfMap, ok := l[0].(map[string]interface{})
+
+	if !ok {
+		return nil
+	}
+
+	apiObject := &opensearchservice.VPCOptions{}
+
+	if v, ok := tfMap["security_group_ids"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.SecurityGroupIds = flex.ExpandStringSet(v)
+	}
+
+	if v, ok := tfMap["subnet_ids"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.SubnetIds = flex.ExpandStringSet(v)
+	}
+
+	return apiObject
+}
+
+func FlattenVPCDerivedInfo(apiObject *opensearchservice.VPCDerivedInfo) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.SecurityGroupIds; v != nil {
+		tfMap["security_group_ids"] = aws.StringValueSlice(v)
+	}
+
+	if v := apiObject.SubnetIds; v != nil {
+		tfMap["subnet_ids"] = aws.StringValueSlice(v)
+	}
+
+	return []interface{}{tfMap}
+}
@@ -1,77 +1,174 @@
 // Copyright (c) HashiCorp, Inc.
 // SPDX-License-Identifier: MPL-2.0
 
-
 package opensearch
 
 import (
 	"context"
-	"errors"
-	"log"
+	"fmt"
+	"strings"
 	"time"
 
-	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/service/opensearchservice"
-	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/aws/aws-sdk-go-v2/service/opensearch"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
-// @SDKResource("aws_opensearch_vpc_endpoint_authorized_principal")
-func ResourceVpcEndpointAuthorizedPrincipal() *schema.Resource {
-	return &schema.Resource{
-		CreateWithoutTimeout: resourceVpcEndpointAuthorizedPrincipalCreate,
-		ReadWithoutTimeout:  resourceAwsOpenSearchDomainVpcEndpointAuthorizedPrincipalRead,
-		UpdateWithoutTimeout: resourceAwsOpenSearchDomainVpcEndpointAuthorizedPrincipalUpdate,
-		DeleteWithoutTimeout: resourceAwsOpenSearchDomainVpcEndpointAuthorizedPrincipalDelete,
+// @FrameworkResource("aws_opensearch_vpc_endpoint_authorized_principal", name="VPC Endpoint Authorized Principal")
+func newResourceVpcEndpointAuthorizedPrincipal(_ context.Context) (resource.ResourceWithConfigure, error) {
+	return &resourceVpcEndpointAuthorizedPrincipal{}, nil
+}
 
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
+type resourceVpcEndpointAuthorizedPrincipal struct {
+	framework.ResourceWithConfigure
+}
 
-		Timeouts: &schema.ResourceTimeout{
-			Create: schema.DefaultTimeout(10 * time.Minute),
-			Update: schema.DefaultTimeout(10 * time.Minute),
-			Delete: schema.DefaultTimeout(10 * time.Minute),
-		},
+func (r *resourceVpcEndpointAuthorizedPrincipal) Metadata(_ context.Context, _ resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_opensearch_vpc_endpoint_authorized_principal"
+}
 
-		Schema: map[string]*schema.Schema{
-			"domain_name": {
-				Type:     schema.TypeString,
+func (r *resourceVpcEndpointAuthorizedPrincipal) Schema(_ context.Context, _ resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": framework.IDAttribute(),
+			"domain_name": schema.StringAttribute{
 				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
-			"account": {
-				Type:        schema.TypeString,
-				Required:    true,
+			"account": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 		},
 	}
 }
 
-func resourceVpcEndpointAuthorizedPrincipalCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	var diags diag.Diagnostics
+type resourceVpcEndpointAuthorizedPrincipalData struct {
+	ID         types.String `tfsdk:"id"`
+	DomainName types.String `tfsdk:"domain_name"`
+	Account    types.String `tfsdk:"account"`
+}
+
+func (r *resourceVpcEndpointAuthorizedPrincipal) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data resourceVpcEndpointAuthorizedPrincipalData
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().OpenSearchClient(ctx)
+	domainName := data.DomainName.ValueString()
+
+	output, err := conn.AuthorizeVpcEndpointAccess(ctx, &opensearch.AuthorizeVpcEndpointAccessInput{
+		DomainName: data.DomainName.ValueStringPointer(),
+		Account:    data.Account.ValueStringPointer(),
+	})
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating OpenSearch VPC Endpoint Authorized Principal (%s)", domainName), err.Error())
+		return
+	}
+
+	data.ID = types.StringValue(authorizedPrincipalID(domainName, output.AuthorizedPrincipal))
+
+	waiter := &OperationWaiter{Ctx: ctx, ConnV2: conn, Target: WaitPrincipalAuthorized, DomainName: domainName, Account: data.Account.ValueString()}
+	if _, err := waiter.Conf(10 * time.Minute).WaitForStateContext(ctx); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for OpenSearch VPC Endpoint Authorized Principal (%s) create", domainName), err.Error())
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *resourceVpcEndpointAuthorizedPrincipal) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data resourceVpcEndpointAuthorizedPrincipalData
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().OpenSearchClient(ctx)
+
+	_, err := FindAuthorizedPrincipal(ctx, conn, data.DomainName.ValueString(), data.Account.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.AddWarning(
+			"OpenSearch VPC Endpoint Authorized Principal not found",
+			fmt.Sprintf("OpenSearch VPC Endpoint Authorized Principal (%s) not found, removing from state", data.ID.ValueString()),
+		)
+		response.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading OpenSearch VPC Endpoint Authorized Principal (%s)", data.ID.ValueString()), err.Error())
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+// Update is required to satisfy resource.Resource, but every attribute of
+// this resource is RequiresReplace, so Terraform never actually calls it;
+// it just passes the planned value straight through.
+func (r *resourceVpcEndpointAuthorizedPrincipal) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var data resourceVpcEndpointAuthorizedPrincipalData
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
 
-	conn := meta.(*conns.AWSClient).OpenSearchConn(ctx)
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
 
-	input := &opensearchservice.AuthorizeVpcEndpointAccessInput{
-		DomainName: aws.String(d.Get("domain_name").(string)),
-		Account: aws.String(d.Get("account").(string))
+func (r *resourceVpcEndpointAuthorizedPrincipal) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data resourceVpcEndpointAuthorizedPrincipalData
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
 	}
 
-	output, err := conn.AuthorizeVpcEndpointAccess(input)
+	conn := r.Meta().OpenSearchClient(ctx)
+	domainName := data.DomainName.ValueString()
 
-	if err != nil{
-		return sdkdiag.AppendErrorf(diags, "Error authorizing VPC endpoint access: %s", err)
+	_, err := conn.RevokeVpcEndpointAccess(ctx, &opensearch.RevokeVpcEndpointAccessInput{
+		DomainName: data.DomainName.ValueStringPointer(),
+		Account:    data.Account.ValueStringPointer(),
+	})
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting OpenSearch VPC Endpoint Authorized Principal (%s)", data.ID.ValueString()), err.Error())
+		return
+	}
+
+	waiter := &OperationWaiter{Ctx: ctx, ConnV2: conn, Target: WaitDomainProcessing, DomainName: domainName}
+	if _, err := waiter.Conf(10 * time.Minute).WaitForStateContext(ctx); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for OpenSearch Domain (%s) update", domainName), err.Error())
+		return
 	}
+}
 
-	d.SetId(aws.StringValue(output.Acount))
+func (r *resourceVpcEndpointAuthorizedPrincipal) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), request, response)
 
-	if err := waitForVpcEndpointAuthorizedPrincipalCreated(conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
-		return diag.FromErr(err)
+	// The ID is authorized-principal-<principal>-<principal type>-<domain name>, as built by authorizedPrincipalID.
+	const prefix = "authorized-principal-"
+	parts := strings.SplitN(strings.TrimPrefix(request.ID, prefix), "-", 3)
+	if !strings.HasPrefix(request.ID, prefix) || len(parts) != 3 {
+		response.Diagnostics.AddError("importing OpenSearch VPC Endpoint Authorized Principal", fmt.Sprintf("unexpected format for ID (%s), expected %s<principal>-<principal type>-<domain name>", request.ID, prefix))
+		return
 	}
 
-	return append(diags)
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("account"), parts[0])...)
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("domain_name"), parts[2])...)
 }
@@ -0,0 +1,194 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package opensearch_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/opensearchservice"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfopensearch "github.com/hashicorp/terraform-provider-aws/internal/service/opensearch"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestExpandVPCOptions(t *testing.T) {
+	t.Parallel()
+
+	if got := tfopensearch.ExpandVPCOptions(nil); got != nil {
+		t.Errorf("expected nil for empty input, got %v", got)
+	}
+
+	got := tfopensearch.ExpandVPCOptions([]interface{}{
+		map[string]interface{}{
+			"subnet_ids":         schema.NewSet(schema.HashString, []interface{}{"subnet-1234"}),
+			"security_group_ids": schema.NewSet(schema.HashString, []interface{}{"sg-1234"}),
+		},
+	})
+
+
+	if got == nil {
+		t.Fatal("expected non-nil VPCOptions")
+	}
+
+	if want := []string{"subnet-1234"}; aws.StringValueSlice(got.SubnetIds)[0] != want[0] {
+		t.Errorf("SubnetIds = %v, want %v", aws.StringValueSlice(got.SubnetIds), want)
+	}
+
+	if want := []string{"sg-1234"}; aws.StringValueSlice(got.SecurityGroupIds)[0] != want[0] {
+		t.Errorf("SecurityGroupIds = %v, want %v", aws.StringValueSlice(got.SecurityGroupIds), want)
+	}
+}
+
+func TestFlattenVPCDerivedInfo(t *testing.T) {
+	t.Parallel()
+
+	if got := tfopensearch.FlattenVPCDerivedInfo(nil); got != nil {
+		t.Errorf("expected nil for nil input, got %v", got)
+	}
+
+	got := tfopensearch.FlattenVPCDerivedInfo(&opensearchservice.VPCDerivedInfo{
+		SubnetIds:        aws.StringSlice([]string{"subnet-1234"}),
+		SecurityGroupIds: aws.StringSlice([]string{"sg-1234"}),
+	})
+
+	if len(got) != 1 {
+		t.Fatalf("expected 1 element, got %d", len(got))
+	}
+
+	tfMap := got[0].(map[string]interface{})
+	if tfMap["subnet_ids"].([]string)[0] != "subnet-1234" {
+		t.Errorf("subnet_ids = %v, want [subnet-1234]", tfMap["subnet_ids"])
+	}
+	if tfMap["security_group_ids"].([]string)[0] != "sg-1234" {
+		t.Errorf("security_group_ids = %v, want [sg-1234]", tfMap["security_group_ids"])
+	}
+}
+
+func TestAccOpenSearchVPCEndpoint_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var vpcEndpoint opensearchservice.VpcEndpoint
+	resourceName := "aws_opensearch_vpc_endpoint.test"
+	domainResourceName := "aws_opensearch_domain.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.OpenSearchServiceEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckVPCEndpointDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCEndpointConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVPCEndpointExists(ctx, resourceName, &vpcEndpoint),
+					resource.TestCheckResourceAttrPair(resourceName, "domain_arn", domainResourceName, "arn"),
+					resource.TestCheckResourceAttrSet(resourceName, "endpoint"),
+					resource.TestCheckResourceAttr(resourceName, "vpc_options.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckVPCEndpointDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).OpenSearchConn(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_opensearch_vpc_endpoint" {
+				continue
+			}
+
+			_, err := tfopensearch.FindVPCEndpointByID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("OpenSearch VPC Endpoint %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckVPCEndpointExists(ctx context.Context, n string, v *opensearchservice.VpcEndpoint) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).OpenSearchConn(ctx)
+
+		output, err := tfopensearch.FindVPCEndpointByID(ctx, conn, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccVPCEndpointConfig_basic(rName string) string {
+	return acctest.ConfigCompose(acctest.ConfigAvailableAZs(), fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  cidr_block = "10.0.0.0/16"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_subnet" "test" {
+  vpc_id            = aws_vpc.test.id
+  cidr_block        = "10.0.1.0/24"
+  availability_zone = data.aws_availability_zones.available.names[0]
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_security_group" "test" {
+  vpc_id = aws_vpc.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_opensearch_domain" "test" {
+  domain_name = %[1]q
+}
+
+resource "aws_opensearch_vpc_endpoint" "test" {
+  domain_arn = aws_opensearch_domain.test.arn
+
+  vpc_options {
+    subnet_ids         = [aws_subnet.test.id]
+    security_group_ids = [aws_security_group.test.id]
+  }
+}
+`, rName))
+}
@@ -0,0 +1,224 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package opensearch
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	awsv1 "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/opensearchservice"
+	awsv2 "github.com/aws/aws-sdk-go-v2/aws"
+	opensearchv2 "github.com/aws/aws-sdk-go-v2/service/opensearch"
+	opensearchv2types "github.com/aws/aws-sdk-go-v2/service/opensearch/types"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// WaitTarget identifies the OpenSearch operation an OperationWaiter polls
+// for completion. Each target maps to its own Describe/List call and its
+// own notion of "pending" vs. "done".
+type WaitTarget int
+
+const (
+	WaitDomainProcessing WaitTarget = iota
+	WaitVpcEndpointActive
+	WaitVpcEndpointDeleted
+	WaitPrincipalAuthorized
+)
+
+const (
+	opensearchDomainStatusProcessing = "Processing"
+	opensearchDomainStatusActive     = "Active"
+
+	opensearchPrincipalStatusPending    = "Pending"
+	opensearchPrincipalStatusAuthorized = "Authorized"
+)
+
+// domainProcessingTimeout bounds how long OperationWaiter will wait for an
+// OpenSearch domain to leave Processing, whether that's from a domain
+// resource's own Create/Update or from reconcileAuthorizedAccounts updating
+// the domain's VPC endpoint access out-of-band.
+const domainProcessingTimeout = 20 * time.Minute
+
+// OperationWaiter polls the OpenSearch API for a WaitTarget's status and
+// satisfies retry.StateRefreshFunc, so any OpenSearch resource can drive a
+// retry.StateChangeConf off of it instead of hand-rolling its own refresher.
+//
+// Which client must be set depends on the target: WaitDomainProcessing uses
+// ConnV2 (aws-sdk-go-v2) when it is set and Conn (aws-sdk-go) otherwise, so
+// Plugin Framework and SDKv2 resources share that target;
+// WaitVpcEndpointActive and WaitVpcEndpointDeleted use only Conn, and
+// WaitPrincipalAuthorized uses only ConnV2.
+type OperationWaiter struct {
+	Conn   *opensearchservice.OpenSearchService
+	ConnV2 *opensearchv2.Client
+	Target WaitTarget
+
+	// Ctx is passed through to the API calls the refreshers make, so
+	// Terraform's operation timeout/cancellation and the provider's
+	// context-based logging middleware reach them. The one exception is the
+	// aws-sdk-go DescribeDomain call made through FindDomainStatusByName,
+	// which takes no context. It must be set.
+	Ctx context.Context
+
+	// DomainName is used by WaitDomainProcessing and WaitPrincipalAuthorized.
+	DomainName string
+	// VpcEndpointId is used by WaitVpcEndpointActive and WaitVpcEndpointDeleted.
+	VpcEndpointId string
+	// Account is used by WaitPrincipalAuthorized.
+	Account string
+}
+
+func (w *OperationWaiter) RefreshFunc() retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		switch w.Target {
+		case WaitDomainProcessing:
+			return w.refreshDomain()
+		case WaitVpcEndpointActive, WaitVpcEndpointDeleted:
+			return w.refreshVpcEndpoint()
+		case WaitPrincipalAuthorized:
+			return w.refreshPrincipal()
+		default:
+			return nil, "", fmt.Errorf("unknown OpenSearch wait target: %d", w.Target)
+		}
+	}
+}
+
+func (w *OperationWaiter) refreshDomain() (interface{}, string, error) {
+	if w.ConnV2 != nil {
+		output, err := findDomainStatusByNameV2(w.Ctx, w.ConnV2, w.DomainName)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		if awsv2.ToBool(output.Processing) {
+			return output, opensearchDomainStatusProcessing, nil
+		}
+
+		return output, opensearchDomainStatusActive, nil
+	}
+
+	output, err := FindDomainStatusByName(w.Conn, w.DomainName)
+
+	if tfresource.NotFound(err) {
+		return nil, "", nil
+	}
+
+	if err != nil {
+		return nil, "", err
+	}
+
+	if awsv1.BoolValue(output.Processing) {
+		return output, opensearchDomainStatusProcessing, nil
+	}
+
+	return output, opensearchDomainStatusActive, nil
+}
+
+func (w *OperationWaiter) refreshVpcEndpoint() (interface{}, string, error) {
+	output, err := FindVPCEndpointByID(w.Ctx, w.Conn, w.VpcEndpointId)
+
+	if tfresource.NotFound(err) {
+		return nil, "", nil
+	}
+
+	if err != nil {
+		return nil, "", err
+	}
+
+	return output, awsv1.StringValue(output.Status), nil
+}
+
+func (w *OperationWaiter) refreshPrincipal() (interface{}, string, error) {
+	principal, err := FindAuthorizedPrincipal(w.Ctx, w.ConnV2, w.DomainName, w.Account)
+
+	if tfresource.NotFound(err) {
+		return nil, opensearchPrincipalStatusPending, nil
+	}
+
+	if err != nil {
+		return nil, "", err
+	}
+
+	return principal, opensearchPrincipalStatusAuthorized, nil
+}
+
+// Conf builds the retry.StateChangeConf for w.Target, using the
+// Pending/Target status pairs appropriate to that target.
+func (w *OperationWaiter) Conf(timeout time.Duration) *retry.StateChangeConf {
+	var pending, target []string
+
+	switch w.Target {
+	case WaitDomainProcessing:
+		pending = []string{opensearchDomainStatusProcessing}
+		target = []string{opensearchDomainStatusActive}
+	case WaitVpcEndpointActive:
+		pending = []string{vpcEndpointStatusCreating, vpcEndpointStatusUpdating}
+		target = []string{vpcEndpointStatusActive}
+	case WaitVpcEndpointDeleted:
+		pending = []string{vpcEndpointStatusDeleting}
+		target = []string{}
+	case WaitPrincipalAuthorized:
+		pending = []string{opensearchPrincipalStatusPending}
+		target = []string{opensearchPrincipalStatusAuthorized}
+	}
+
+	return &retry.StateChangeConf{
+		Pending: pending,
+		Target:  target,
+		Refresh: w.RefreshFunc(),
+		Timeout: timeout,
+	}
+}
+
+func FindDomainStatusByName(conn *opensearchservice.OpenSearchService, name string) (*opensearchservice.DomainStatus, error) {
+	input := &opensearchservice.DescribeDomainInput{
+		DomainName: awsv1.String(name),
+	}
+
+	output, err := conn.DescribeDomain(input)
+
+	if tfawserr.ErrCodeEquals(err, opensearchservice.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.DomainStatus == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.DomainStatus, nil
+}
+
+func findDomainStatusByNameV2(ctx context.Context, conn *opensearchv2.Client, name string) (*opensearchv2types.DomainStatus, error) {
+	input := &opensearchv2.DescribeDomainInput{
+		DomainName: awsv2.String(name),
+	}
+
+	output, err := conn.DescribeDomain(ctx, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.DomainStatus == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.DomainStatus, nil
+}
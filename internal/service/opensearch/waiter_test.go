@@ -0,0 +1,91 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package opensearch
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestOperationWaiterConf(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		target      WaitTarget
+		wantPending []string
+		wantTarget  []string
+	}{
+		"domain processing": {
+			target:      WaitDomainProcessing,
+			wantPending: []string{opensearchDomainStatusProcessing},
+			wantTarget:  []string{opensearchDomainStatusActive},
+		},
+		"vpc endpoint active": {
+			target:      WaitVpcEndpointActive,
+			wantPending: []string{vpcEndpointStatusCreating, vpcEndpointStatusUpdating},
+			wantTarget:  []string{vpcEndpointStatusActive},
+		},
+		"vpc endpoint deleted": {
+			target:      WaitVpcEndpointDeleted,
+			wantPending: []string{vpcEndpointStatusDeleting},
+			wantTarget:  []string{},
+		},
+		"principal authorized": {
+			target:      WaitPrincipalAuthorized,
+			wantPending: []string{opensearchPrincipalStatusPending},
+			wantTarget:  []string{opensearchPrincipalStatusAuthorized},
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			w := &OperationWaiter{Ctx: context.Background(), Target: tc.target}
+			conf := w.Conf(time.Minute)
+
+			if !stringSlicesEqual(conf.Pending, tc.wantPending) {
+				t.Errorf("Pending = %v, want %v", conf.Pending, tc.wantPending)
+			}
+
+			if !stringSlicesEqual(conf.Target, tc.wantTarget) {
+				t.Errorf("Target = %v, want %v", conf.Target, tc.wantTarget)
+			}
+
+			if conf.Refresh == nil {
+				t.Error("Refresh is nil")
+			}
+
+			if conf.Timeout != time.Minute {
+				t.Errorf("Timeout = %v, want %v", conf.Timeout, time.Minute)
+			}
+		})
+	}
+}
+
+func TestOperationWaiterRefreshFunc_unknownTarget(t *testing.T) {
+	t.Parallel()
+
+	w := &OperationWaiter{Ctx: context.Background(), Target: WaitTarget(-1)}
+
+	if _, _, err := w.RefreshFunc()(); err == nil {
+		t.Error("expected an error for an unknown wait target")
+	}
+}
+
+func stringSlicesEqual(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+
+	return true
+}